@@ -0,0 +1,81 @@
+// Package config defines the configuration the Inspector's rpc package
+// builds its server from. It exists in this tree as the slice of
+// Tendermint's full config package that the Inspector's rpc server needs;
+// the rest of config.Config (p2p, consensus, mempool, ...) lives outside
+// this slice.
+package config
+
+import "time"
+
+// RPCConfig carries the options the Inspector's rpc.Server needs to serve
+// JSON-RPC, websocket, and gRPC traffic: request size and timeout limits,
+// CORS, and (via InspectorAuth/InspectorRateLimit) the auth and rate
+// limiting settings applied to both transports: auth by rpc.Handler and
+// rate limits by rpc.Routes on the JSON-RPC side, both by
+// rpc.NewGRPCServer on the gRPC side.
+type RPCConfig struct {
+	// MaxOpenConnections limits how many simultaneous connections the
+	// listener accepts; zero means unlimited.
+	MaxOpenConnections int
+	// MaxBodyBytes limits the size of a JSON-RPC request body.
+	MaxBodyBytes int64
+	// MaxHeaderBytes limits the size of the request header.
+	MaxHeaderBytes int
+	// TimeoutBroadcastTxCommit is the longest a broadcast_tx_commit-style
+	// call may block; the HTTP server's WriteTimeout is widened to exceed
+	// it (see serverRPCConfig in inspect/rpc/rpc.go).
+	TimeoutBroadcastTxCommit time.Duration
+
+	// CORSAllowedOrigins, CORSAllowedMethods, and CORSAllowedHeaders
+	// configure the CORS middleware Handler applies when IsCorsEnabled.
+	CORSAllowedOrigins []string
+	CORSAllowedMethods []string
+	CORSAllowedHeaders []string
+
+	// InspectorAuth carries the bearer-token/JWT, mTLS, and CIDR allowlist
+	// settings applied to both the JSON-RPC/websocket and gRPC transports;
+	// see rpc.NewAuthConfig.
+	InspectorAuth RPCAuthConfig
+	// InspectorRateLimit carries the per-method and per-remote-IP rate
+	// limits applied to both transports; see rpc.NewRateLimitConfig.
+	InspectorRateLimit RPCRateLimitConfig
+}
+
+// IsCorsEnabled reports whether any CORS origin is configured.
+func (cfg RPCConfig) IsCorsEnabled() bool {
+	return len(cfg.CORSAllowedOrigins) != 0
+}
+
+// RPCAuthConfig is the serializable (TOML-friendly) form of the
+// Inspector's auth settings. JWKSURL and ClientCAFile hold paths/URLs
+// rather than the loaded JWTVerifier/*x509.CertPool rpc.AuthConfig needs at
+// runtime; rpc.NewAuthConfig takes the two together and builds the latter.
+type RPCAuthConfig struct {
+	// BearerTokens is the set of static tokens accepted in the
+	// "Authorization: Bearer <token>" header. Ignored if JWKSURL is set.
+	BearerTokens []string
+	// JWKSURL, if set, is the JSON Web Key Set endpoint bearer tokens are
+	// verified against instead of BearerTokens.
+	JWKSURL string
+	// CIDRAllowlist restricts which source networks may reach the server
+	// at all. An empty list allows every address.
+	CIDRAllowlist []string
+	// ClientCAFile, if set, is the PEM file of CAs ListenAndServeTLS trusts
+	// to sign client certificates for mTLS.
+	ClientCAFile string
+}
+
+// RPCRateLimitConfig is the serializable form of the Inspector's rate
+// limiting settings; see rpc.NewRateLimitConfig.
+type RPCRateLimitConfig struct {
+	Default   RPCRate
+	PerMethod map[string]RPCRate
+}
+
+// RPCRate describes a token-bucket limit: up to RequestsPerSecond
+// sustained requests, with bursts up to Burst. A zero-value RPCRate
+// (RequestsPerSecond <= 0) is treated as unlimited.
+type RPCRate struct {
+	RequestsPerSecond float64
+	Burst             int
+}
@@ -0,0 +1,212 @@
+// Package bls12381 implements the IETF "min-pubkey-size" BLS ciphersuite
+// (BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_): private keys are scalars,
+// public keys are points on G1 (48-byte compressed), and signatures are
+// points on G2 (96-byte compressed). The small public keys and the
+// proof-of-possession (POP) scheme make this ciphersuite a good fit for
+// aggregating one signature per validator into a single commit signature,
+// which is the intended use of this package: signers produce a BLS
+// signature over a 32-byte root such as the one returned by
+// canonical.SigningRoot, and a verifier can check N signers at once with a
+// single pairing via FastAggregateVerify instead of N individual
+// signature checks.
+//
+// This package does not implement curve or pairing arithmetic itself; it
+// wraps github.com/kilic/bls12-381, a pure-Go implementation of the
+// BLS12-381 curve, field, and pairing engine.
+package bls12381
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	bls "github.com/kilic/bls12-381"
+)
+
+const (
+	// PrivKeySize is the length of a PrivKey: a big-endian scalar in the
+	// scalar field of the curve's pairing-friendly group order.
+	PrivKeySize = 32
+	// PubKeySize is the length of a compressed G1 point.
+	PubKeySize = 48
+	// SignatureSize is the length of a compressed G2 point.
+	SignatureSize = 96
+
+	// sigDST is the hash-to-curve domain separation tag for ordinary
+	// signatures, as fixed by the ciphersuite name itself.
+	sigDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+	// popDST is the domain separation tag used for proof-of-possession
+	// signatures, so a PoP can never be replayed as a signature over
+	// attacker-chosen data and vice versa.
+	popDST = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+// PrivKey is a BLS12-381 private key: a scalar used to derive a PubKey on
+// G1 and to produce Signatures on G2.
+type PrivKey [PrivKeySize]byte
+
+// PubKey is a compressed G1 point.
+type PubKey [PubKeySize]byte
+
+// Signature is a compressed G2 point.
+type Signature [SignatureSize]byte
+
+// GenPrivKey generates a new private key using crypto/rand, rejection
+// sampling against the scalar field order so the result is uniform over
+// [1, r) rather than merely uniform over 32 bytes.
+func GenPrivKey() (PrivKey, error) {
+	fr := bls.NewFr()
+	for {
+		var buf [PrivKeySize]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			return PrivKey{}, fmt.Errorf("bls12381: read random scalar: %w", err)
+		}
+		if fr.SetBytes(buf[:]) == nil {
+			continue // buf was >= the field order; resample.
+		}
+		var out PrivKey
+		copy(out[:], fr.ToBytes())
+		return out, nil
+	}
+}
+
+// PubKey derives the public key sk*G1 for sk.
+func (sk PrivKey) PubKey() (PubKey, error) {
+	fr := bls.NewFr()
+	if fr.SetBytes(sk[:]) == nil {
+		return PubKey{}, fmt.Errorf("bls12381: private key is not a valid scalar")
+	}
+	g1 := bls.NewG1()
+	p := g1.New()
+	g1.MulScalar(p, g1.One(), fr.ToRed())
+	var out PubKey
+	copy(out[:], g1.ToCompressed(p))
+	return out, nil
+}
+
+// Sign signs msg, hashing it onto G2 under sigDST and multiplying by sk.
+// Per the ciphersuite's pairing equation, every signer over the same msg
+// produces a signature that aggregates with every other signer's via
+// simple point addition (see AggregateSignatures), regardless of which
+// CanonicalVote/CanonicalProposal fields were used to derive msg.
+func (sk PrivKey) Sign(msg []byte) (Signature, error) {
+	fr := bls.NewFr()
+	if fr.SetBytes(sk[:]) == nil {
+		return Signature{}, fmt.Errorf("bls12381: private key is not a valid scalar")
+	}
+	g2 := bls.NewG2()
+	p, err := g2.HashToCurve(msg, []byte(sigDST))
+	if err != nil {
+		return Signature{}, fmt.Errorf("bls12381: hash to curve: %w", err)
+	}
+	g2.MulScalar(p, p, fr.ToRed())
+	var out Signature
+	copy(out[:], g2.ToCompressed(p))
+	return out, nil
+}
+
+// ProvePossession signs sk's own public key under popDST, establishing a
+// proof of possession that callers must verify (PopVerify) before folding
+// an untrusted PubKey into an aggregate, defending against rogue-key
+// attacks on aggregate signature schemes.
+func (sk PrivKey) ProvePossession() (Signature, error) {
+	pub, err := sk.PubKey()
+	if err != nil {
+		return Signature{}, err
+	}
+	fr := bls.NewFr()
+	if fr.SetBytes(sk[:]) == nil {
+		return Signature{}, fmt.Errorf("bls12381: private key is not a valid scalar")
+	}
+	g2 := bls.NewG2()
+	p, err := g2.HashToCurve(pub[:], []byte(popDST))
+	if err != nil {
+		return Signature{}, fmt.Errorf("bls12381: hash to curve: %w", err)
+	}
+	g2.MulScalar(p, p, fr.ToRed())
+	var out Signature
+	copy(out[:], g2.ToCompressed(p))
+	return out, nil
+}
+
+// PopVerify reports whether pop is a valid proof of possession of pk.
+func PopVerify(pk PubKey, pop Signature) (bool, error) {
+	return verify(pk, pk[:], pop, popDST)
+}
+
+// Verify reports whether sig is pk's signature over msg.
+func (pk PubKey) Verify(msg []byte, sig Signature) (bool, error) {
+	return verify(pk, msg, sig, sigDST)
+}
+
+func verify(pk PubKey, msg []byte, sig Signature, dst string) (bool, error) {
+	g1 := bls.NewG1()
+	pubPoint, err := g1.FromCompressed(pk[:])
+	if err != nil {
+		return false, fmt.Errorf("bls12381: decompress public key: %w", err)
+	}
+	g2 := bls.NewG2()
+	sigPoint, err := g2.FromCompressed(sig[:])
+	if err != nil {
+		return false, fmt.Errorf("bls12381: decompress signature: %w", err)
+	}
+	msgPoint, err := g2.HashToCurve(msg, []byte(dst))
+	if err != nil {
+		return false, fmt.Errorf("bls12381: hash to curve: %w", err)
+	}
+
+	// e(sig, G1) == e(H(msg), pk)  <=>  e(sig, G1) * e(H(msg), pk)^-1 == 1,
+	// checked as a single pairing-engine product in which the (pk, H(msg))
+	// pair is inverted, which is cheaper than computing two pairings
+	// separately.
+	engine := bls.NewPairingEngine()
+	engine.AddPair(g1.One(), sigPoint)
+	engine.AddPairInv(pubPoint, msgPoint)
+	return engine.Check(), nil
+}
+
+// AggregateSignatures combines sigs into a single signature by summing
+// their G2 points. The result verifies against the matching aggregate of
+// public keys (FastAggregateVerify) iff every input signature was produced
+// over the same message, which holds for a block commit since every
+// validator signs identical CanonicalVote bytes for that block.
+func AggregateSignatures(sigs []Signature) (Signature, error) {
+	if len(sigs) == 0 {
+		return Signature{}, fmt.Errorf("bls12381: cannot aggregate zero signatures")
+	}
+	g2 := bls.NewG2()
+	acc := g2.Zero()
+	for i, sig := range sigs {
+		p, err := g2.FromCompressed(sig[:])
+		if err != nil {
+			return Signature{}, fmt.Errorf("bls12381: decompress signature %d: %w", i, err)
+		}
+		g2.Add(acc, acc, p)
+	}
+	var out Signature
+	copy(out[:], g2.ToCompressed(acc))
+	return out, nil
+}
+
+// FastAggregateVerify reports whether aggSig is a valid aggregate of each
+// signer in pubKeys' individual signature over the single shared message
+// msg. Every pubKeys entry must have already passed PopVerify at the time
+// it was added to the validator set; FastAggregateVerify itself does not
+// re-check proofs of possession, the same tradeoff the IETF ciphersuite
+// makes in exchange for a single pairing check instead of one per signer.
+func FastAggregateVerify(pubKeys []PubKey, msg []byte, aggSig Signature) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, fmt.Errorf("bls12381: cannot verify against zero public keys")
+	}
+	g1 := bls.NewG1()
+	aggPub := g1.Zero()
+	for i, pk := range pubKeys {
+		p, err := g1.FromCompressed(pk[:])
+		if err != nil {
+			return false, fmt.Errorf("bls12381: decompress public key %d: %w", i, err)
+		}
+		g1.Add(aggPub, aggPub, p)
+	}
+	var compressed PubKey
+	copy(compressed[:], g1.ToCompressed(aggPub))
+	return verify(compressed, msg, aggSig, sigDST)
+}
@@ -0,0 +1,188 @@
+package bls12381
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenPrivKeyProducesValidScalar(t *testing.T) {
+	for i := 0; i < 16; i++ {
+		sk, err := GenPrivKey()
+		require.NoError(t, err)
+		_, err = sk.PubKey()
+		require.NoError(t, err, "GenPrivKey must always produce a scalar PubKey accepts")
+	}
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	sk, err := GenPrivKey()
+	require.NoError(t, err)
+	pk, err := sk.PubKey()
+	require.NoError(t, err)
+
+	msg := []byte("tendermint-vote-v1|test-chain|block-root")
+	sig, err := sk.Sign(msg)
+	require.NoError(t, err)
+
+	ok, err := pk.Verify(msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok, "a signature must verify against the message it was produced over")
+
+	ok, err = pk.Verify([]byte("a different message"), sig)
+	require.NoError(t, err)
+	require.False(t, ok, "a signature must not verify against a different message")
+
+	otherSK, err := GenPrivKey()
+	require.NoError(t, err)
+	otherPK, err := otherSK.PubKey()
+	require.NoError(t, err)
+	ok, err = otherPK.Verify(msg, sig)
+	require.NoError(t, err)
+	require.False(t, ok, "a signature must not verify against a different signer's public key")
+}
+
+func TestProvePossessionRoundTrip(t *testing.T) {
+	sk, err := GenPrivKey()
+	require.NoError(t, err)
+	pk, err := sk.PubKey()
+	require.NoError(t, err)
+
+	pop, err := sk.ProvePossession()
+	require.NoError(t, err)
+
+	ok, err := PopVerify(pk, pop)
+	require.NoError(t, err)
+	require.True(t, ok, "a proof of possession must verify against its own public key")
+
+	otherSK, err := GenPrivKey()
+	require.NoError(t, err)
+	otherPK, err := otherSK.PubKey()
+	require.NoError(t, err)
+	ok, err = PopVerify(otherPK, pop)
+	require.NoError(t, err)
+	require.False(t, ok, "a proof of possession must not verify against a different signer's public key")
+}
+
+func TestProvePossessionIsNotASignatureOverThePubKeyBytes(t *testing.T) {
+	// popDST and sigDST must keep a PoP from being replayable as an
+	// ordinary signature over the signer's own public key bytes, and
+	// vice versa.
+	sk, err := GenPrivKey()
+	require.NoError(t, err)
+	pk, err := sk.PubKey()
+	require.NoError(t, err)
+
+	pop, err := sk.ProvePossession()
+	require.NoError(t, err)
+	ok, err := pk.Verify(pk[:], pop)
+	require.NoError(t, err)
+	require.False(t, ok, "a PoP must not verify as an ordinary signature over the public key bytes")
+
+	sig, err := sk.Sign(pk[:])
+	require.NoError(t, err)
+	ok, err = PopVerify(pk, sig)
+	require.NoError(t, err)
+	require.False(t, ok, "an ordinary signature over the public key bytes must not verify as a PoP")
+}
+
+func TestAggregateSignaturesAndFastAggregateVerify(t *testing.T) {
+	const n = 5
+	msg := []byte("shared-canonical-vote-root")
+
+	pubKeys := make([]PubKey, n)
+	sigs := make([]Signature, n)
+	for i := 0; i < n; i++ {
+		sk, err := GenPrivKey()
+		require.NoError(t, err)
+		pk, err := sk.PubKey()
+		require.NoError(t, err)
+		sig, err := sk.Sign(msg)
+		require.NoError(t, err)
+		pubKeys[i] = pk
+		sigs[i] = sig
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+
+	ok, err := FastAggregateVerify(pubKeys, msg, aggSig)
+	require.NoError(t, err)
+	require.True(t, ok, "an aggregate of signatures over the same message must verify against the aggregate of their public keys")
+
+	// Dropping one signer's public key from the aggregate must invalidate it.
+	ok, err = FastAggregateVerify(pubKeys[:n-1], msg, aggSig)
+	require.NoError(t, err)
+	require.False(t, ok, "FastAggregateVerify must fail if a signer's public key is missing from the aggregate")
+}
+
+func TestAggregateSignaturesRejectsEmptyInput(t *testing.T) {
+	_, err := AggregateSignatures(nil)
+	require.Error(t, err)
+}
+
+func TestFastAggregateVerifyRejectsEmptyPubKeys(t *testing.T) {
+	sk, err := GenPrivKey()
+	require.NoError(t, err)
+	sig, err := sk.Sign([]byte("msg"))
+	require.NoError(t, err)
+
+	_, err = FastAggregateVerify(nil, []byte("msg"), sig)
+	require.Error(t, err)
+}
+
+// BenchmarkCommitSize reports N*SignatureSize against SignatureSize for a
+// few validator-set sizes, the compact-commit savings FastAggregateVerify
+// exists to realize: one aggregate signature replaces N individual ones on
+// the wire, regardless of how many validators signed.
+func BenchmarkCommitSize(b *testing.B) {
+	for _, n := range []int{32, 100, 150} {
+		b.Run(fmt.Sprintf("validators=%d", n), func(b *testing.B) {
+			b.ReportMetric(float64(n*SignatureSize), "individual-bytes")
+			b.ReportMetric(float64(SignatureSize), "aggregate-bytes")
+		})
+	}
+}
+
+// BenchmarkFastAggregateVerify compares a single FastAggregateVerify call
+// over an aggregate signature against the cost of N sequential Verify
+// calls over the same N signatures, the CPU side of the same aggregation
+// tradeoff BenchmarkCommitSize measures on the wire.
+func BenchmarkFastAggregateVerify(b *testing.B) {
+	msg := []byte("shared-canonical-vote-root")
+
+	for _, n := range []int{32, 100, 150} {
+		pubKeys := make([]PubKey, n)
+		sigs := make([]Signature, n)
+		for i := 0; i < n; i++ {
+			sk, err := GenPrivKey()
+			require.NoError(b, err)
+			pk, err := sk.PubKey()
+			require.NoError(b, err)
+			sig, err := sk.Sign(msg)
+			require.NoError(b, err)
+			pubKeys[i] = pk
+			sigs[i] = sig
+		}
+		aggSig, err := AggregateSignatures(sigs)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("aggregate/validators=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := FastAggregateVerify(pubKeys, msg, aggSig); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("sequential/validators=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range pubKeys {
+					if _, err := pubKeys[j].Verify(msg, sigs[j]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestCIDRAllowlistMiddlewareAllowsConfiguredNetwork(t *testing.T) {
+	h := cidrAllowlistMiddleware([]string{"10.0.0.0/8"}, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.1.2.3:1234"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCIDRAllowlistMiddlewareRejectsUnlistedAddress(t *testing.T) {
+	h := cidrAllowlistMiddleware([]string{"10.0.0.0/8"}, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "192.168.1.1:1234"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCIDRAllowlistMiddlewareIgnoresMalformedCIDR(t *testing.T) {
+	// A malformed entry is logged and skipped rather than rejecting every
+	// request or panicking; the remaining valid entries still apply.
+	h := cidrAllowlistMiddleware([]string{"not-a-cidr", "10.0.0.0/8"}, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.1.2.3:1234"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCIDRAllowlistMiddlewareEmptyListIsNoOp(t *testing.T) {
+	h := cidrAllowlistMiddleware(nil, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "8.8.8.8:1234"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestBearerAuthMiddlewareRejectsMissingToken(t *testing.T) {
+	cfg := &AuthConfig{BearerTokens: []string{"secret"}}
+	h := bearerAuthMiddleware(cfg, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestBearerAuthMiddlewareRejectsInvalidToken(t *testing.T) {
+	cfg := &AuthConfig{BearerTokens: []string{"secret"}}
+	h := bearerAuthMiddleware(cfg, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestBearerAuthMiddlewareAllowsValidToken(t *testing.T) {
+	cfg := &AuthConfig{BearerTokens: []string{"secret"}}
+	h := bearerAuthMiddleware(cfg, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+type fakeJWTVerifier struct {
+	err error
+}
+
+func (v fakeJWTVerifier) Verify(_ context.Context, _ string) error {
+	return v.err
+}
+
+func TestBearerAuthMiddlewareRejectsJWKSVerificationFailure(t *testing.T) {
+	cfg := &AuthConfig{JWKSVerifier: fakeJWTVerifier{err: errors.New("signature invalid")}}
+	h := bearerAuthMiddleware(cfg, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer whatever")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestBearerAuthMiddlewareAllowsJWKSVerificationSuccess(t *testing.T) {
+	cfg := &AuthConfig{JWKSVerifier: fakeJWTVerifier{}}
+	h := bearerAuthMiddleware(cfg, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer whatever")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestBearerAuthMiddlewareNoopWhenUnconfigured(t *testing.T) {
+	cfg := &AuthConfig{}
+	h := bearerAuthMiddleware(cfg, log.NewNopLogger(), okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
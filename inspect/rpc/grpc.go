@@ -0,0 +1,272 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/config"
+	inspectgrpc "github.com/tendermint/tendermint/proto/tendermint/rpc/grpc"
+	"github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/indexer"
+	"github.com/tendermint/tendermint/state/txindex"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// GRPCConfig carries the options used to construct the gRPC and gRPC-Web
+// transports served alongside the JSON-RPC handler.
+type GRPCConfig struct {
+	// AllowedOrigins restricts which Origins a gRPC-Web browser client may
+	// call from. An empty list allows any origin.
+	AllowedOrigins []string
+}
+
+// DefaultGRPCConfig returns the default gRPC transport configuration.
+func DefaultGRPCConfig() *GRPCConfig {
+	return &GRPCConfig{}
+}
+
+// NewGRPCServer builds the *grpc.Server that exposes the InspectorService
+// defined in proto/tendermint/rpc/grpc/service.proto over the same
+// core.Environment used by Routes. auth, limiter, and metrics apply the
+// same authentication, rate limiting, and instrumentation Handler applies
+// to the JSON-RPC transport, via unary interceptors rather than HTTP
+// middleware; any of the three may be nil to disable it.
+func NewGRPCServer(cfg config.RPCConfig, s state.Store, bs state.BlockStore, txidx txindex.TxIndexer, blkidx indexer.BlockIndexer, auth *AuthConfig, limiter *RateLimiter, metrics *Metrics, logger log.Logger) *grpc.Server {
+	env := &core.Environment{
+		Config:           cfg,
+		BlockIndexer:     blkidx,
+		TxIndexer:        txidx,
+		StateStore:       s,
+		BlockStore:       bs,
+		ConsensusReactor: waitSyncCheckerImpl{},
+		Logger:           logger,
+	}
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		metricsUnaryInterceptor(metrics),
+		authUnaryInterceptor(auth, logger),
+		rateLimitUnaryInterceptor(limiter),
+	))
+	inspectgrpc.RegisterInspectorServiceServer(srv, &inspectorService{env: env})
+	return srv
+}
+
+// inspectorService adapts core.Environment's archival RPC methods to the
+// typed InspectorService gRPC interface, converting each ctypes.Result*
+// into the matching message from service.proto field by field.
+type inspectorService struct {
+	inspectgrpc.UnimplementedInspectorServiceServer
+
+	env *core.Environment
+}
+
+func (s *inspectorService) BlockchainInfo(_ context.Context, req *inspectgrpc.BlockchainInfoRequest) (*inspectgrpc.BlockchainInfoResponse, error) {
+	result, err := s.env.BlockchainInfo(&rpctypes.Context{}, req.MinHeight, req.MaxHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &inspectgrpc.BlockchainInfoResponse{
+		LastHeight: result.LastHeight,
+		BlockMetas: result.BlockMetas,
+	}, nil
+}
+
+func (s *inspectorService) ConsensusParams(_ context.Context, req *inspectgrpc.ConsensusParamsRequest) (*inspectgrpc.ConsensusParamsResponse, error) {
+	result, err := s.env.ConsensusParams(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &inspectgrpc.ConsensusParamsResponse{
+		BlockHeight:     result.BlockHeight,
+		ConsensusParams: &result.ConsensusParams,
+	}, nil
+}
+
+func (s *inspectorService) Block(_ context.Context, req *inspectgrpc.BlockRequest) (*inspectgrpc.BlockResponse, error) {
+	result, err := s.env.Block(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return blockResponse(result), nil
+}
+
+func (s *inspectorService) BlockByHash(_ context.Context, req *inspectgrpc.BlockByHashRequest) (*inspectgrpc.BlockResponse, error) {
+	if len(req.Hash) == 0 {
+		return nil, errMissingParam("hash")
+	}
+	result, err := s.env.BlockByHash(&rpctypes.Context{}, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return blockResponse(result), nil
+}
+
+func (s *inspectorService) BlockResults(_ context.Context, req *inspectgrpc.BlockResultsRequest) (*inspectgrpc.BlockResultsResponse, error) {
+	result, err := s.env.BlockResults(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	validatorUpdates := make([]*abci.ValidatorUpdate, len(result.ValidatorUpdates))
+	for i := range result.ValidatorUpdates {
+		validatorUpdates[i] = &result.ValidatorUpdates[i]
+	}
+	return &inspectgrpc.BlockResultsResponse{
+		Height:                result.Height,
+		TxResults:             result.TxsResults,
+		ValidatorUpdates:      validatorUpdates,
+		ConsensusParamUpdates: result.ConsensusParamUpdates,
+		AppHash:               result.AppHash,
+	}, nil
+}
+
+func (s *inspectorService) Commit(_ context.Context, req *inspectgrpc.CommitRequest) (*inspectgrpc.CommitResponse, error) {
+	result, err := s.env.Commit(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &inspectgrpc.CommitResponse{
+		SignedHeader: &result.SignedHeader,
+		Canonical:    result.CanonicalCommit,
+	}, nil
+}
+
+func (s *inspectorService) Validators(_ context.Context, req *inspectgrpc.ValidatorsRequest) (*inspectgrpc.ValidatorsResponse, error) {
+	result, err := s.env.Validators(&rpctypes.Context{}, heightPtr(req.Height), intPtr(req.Page), intPtr(req.PerPage))
+	if err != nil {
+		return nil, err
+	}
+	return &inspectgrpc.ValidatorsResponse{
+		BlockHeight: result.BlockHeight,
+		Validators:  result.Validators,
+		Count:       int32(result.Count),
+		Total:       int32(result.Total),
+	}, nil
+}
+
+func (s *inspectorService) Tx(_ context.Context, req *inspectgrpc.TxRequest) (*inspectgrpc.TxResponse, error) {
+	if len(req.Hash) == 0 {
+		return nil, errMissingParam("hash")
+	}
+	result, err := s.env.Tx(&rpctypes.Context{}, req.Hash, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return txResponse(result), nil
+}
+
+func (s *inspectorService) TxSearch(_ context.Context, req *inspectgrpc.TxSearchRequest) (*inspectgrpc.TxSearchResponse, error) {
+	result, err := s.env.TxSearch(&rpctypes.Context{}, req.Query, req.Prove, intPtr(req.Page), intPtr(req.PerPage), req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*inspectgrpc.TxResponse, len(result.Txs))
+	for i, tx := range result.Txs {
+		txs[i] = txResponse(tx)
+	}
+	return &inspectgrpc.TxSearchResponse{
+		Txs:        txs,
+		TotalCount: int32(result.TotalCount),
+	}, nil
+}
+
+func (s *inspectorService) BlockSearch(_ context.Context, req *inspectgrpc.BlockSearchRequest) (*inspectgrpc.BlockSearchResponse, error) {
+	result, err := s.env.BlockSearch(&rpctypes.Context{}, req.Query, intPtr(req.Page), intPtr(req.PerPage), req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*inspectgrpc.BlockResponse, len(result.Blocks))
+	for i, block := range result.Blocks {
+		blocks[i] = blockResponse(block)
+	}
+	return &inspectgrpc.BlockSearchResponse{
+		Blocks:     blocks,
+		TotalCount: int32(result.TotalCount),
+	}, nil
+}
+
+func blockResponse(result *ctypes.ResultBlock) *inspectgrpc.BlockResponse {
+	return &inspectgrpc.BlockResponse{
+		BlockID: &result.BlockID,
+		Block:   result.Block,
+	}
+}
+
+func txResponse(result *ctypes.ResultTx) *inspectgrpc.TxResponse {
+	return &inspectgrpc.TxResponse{
+		Hash:     result.Hash,
+		Height:   result.Height,
+		Index:    result.Index,
+		TxResult: &result.TxResult,
+		Tx:       result.Tx,
+		Proof:    &result.Proof.Proof,
+	}
+}
+
+// grpcWebHandler wraps srv.GRPCServer with a gRPC-Web shim so browser
+// clients can call the InspectorService without a sidecar proxy, and falls
+// back to plain gRPC for native clients talking HTTP/2 directly.
+func (srv *Server) grpcWebHandler(grpcServer *grpc.Server) http.Handler {
+	cfg := srv.GRPCConfig
+	if cfg == nil {
+		cfg = DefaultGRPCConfig()
+	}
+	wrapped := grpcweb.WrapServer(grpcServer,
+		grpcweb.WithOriginFunc(func(origin string) bool {
+			if len(cfg.AllowedOrigins) == 0 {
+				return true
+			}
+			for _, allowed := range cfg.AllowedOrigins {
+				if allowed == origin {
+					return true
+				}
+			}
+			return false
+		}),
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) || wrapped.IsAcceptableGrpcCorsRequest(r) {
+			wrapped.ServeHTTP(w, r)
+			return
+		}
+		grpcServer.ServeHTTP(w, r)
+	})
+}
+
+// heightPtr converts a request's zero-value height field back into the nil
+// *int64 core.Environment's methods take to mean "the latest height", the
+// same convention the request/response messages document in service.proto.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+// intPtr is heightPtr's counterpart for the int32 page/per_page fields,
+// which core.Environment's methods take as *int.
+func intPtr(v int32) *int {
+	if v == 0 {
+		return nil
+	}
+	n := int(v)
+	return &n
+}
+
+func errMissingParam(name string) error {
+	return &missingParamError{name: name}
+}
+
+type missingParamError struct {
+	name string
+}
+
+func (e *missingParamError) Error() string {
+	return "missing required gRPC param: " + e.name
+}
@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// authUnaryInterceptor is authMiddleware's gRPC counterpart: it applies the
+// same CIDR allowlist and bearer-token/JWT checks to every InspectorService
+// call, since NewGRPCServer's transport has no access to Handler's HTTP
+// middleware chain. A nil cfg is a no-op.
+func authUnaryInterceptor(cfg *AuthConfig, logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if cfg == nil {
+			return handler(ctx, req)
+		}
+		if len(cfg.CIDRAllowlist) > 0 {
+			if !grpcRemoteAllowed(ctx, cfg.CIDRAllowlist, logger) {
+				return nil, status.Error(codes.PermissionDenied, "remote address not in allowlist")
+			}
+		}
+		if len(cfg.BearerTokens) > 0 || cfg.JWKSVerifier != nil {
+			token, ok := grpcBearerToken(ctx)
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+			}
+			if cfg.JWKSVerifier != nil {
+				if err := cfg.JWKSVerifier.Verify(ctx, token); err != nil {
+					logger.Debug("rejected bearer token", "err", err)
+					return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+				}
+			} else if !bearerTokenAllowed(cfg.BearerTokens, token) {
+				return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func grpcRemoteAllowed(ctx context.Context, cidrs []string, logger log.Logger) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			logger.Error("ignoring invalid CIDR in rpc allowlist", "cidr", c, "err", err)
+			continue
+		}
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func grpcBearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return "", false
+	}
+	const prefix = "Bearer "
+	if !strings.HasPrefix(vals[0], prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(vals[0], prefix), true
+}
+
+func bearerTokenAllowed(tokens []string, token string) bool {
+	for _, candidate := range tokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitUnaryInterceptor applies limiter to every InspectorService call,
+// keyed by the unqualified gRPC method name (e.g. "BlockchainInfo") rather
+// than the JSON-RPC route name ("blockchain"), since the two transports
+// expose distinct method namespaces sharing the same RateLimiter budget
+// tracking. A nil limiter is a no-op (see RateLimiter.Allow).
+func rateLimitUnaryInterceptor(limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		remoteAddr := ""
+		if p, ok := peer.FromContext(ctx); ok {
+			remoteAddr = p.Addr.String()
+		}
+		if !limiter.Allow(grpcMethodName(info.FullMethod), remoteAddr) {
+			return nil, status.Error(codes.ResourceExhausted, ErrRateLimited.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// metricsUnaryInterceptor is metricsMiddleware's gRPC counterpart. It
+// records the same requestsTotal/requestDuration/inFlight/errorsTotal
+// collectors as the JSON-RPC transport, labelled by gRPC method name; it
+// has no HTTP response body to measure, so responseBytes is left to the
+// JSON-RPC transport only. A nil m is a no-op.
+func metricsUnaryInterceptor(m *Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if m == nil {
+			return handler(ctx, req)
+		}
+		method := grpcMethodName(info.FullMethod)
+		m.inFlight.WithLabelValues(method).Inc()
+		defer m.inFlight.WithLabelValues(method).Dec()
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.requestsTotal.WithLabelValues(method).Inc()
+		m.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		if err != nil {
+			m.errorsTotal.WithLabelValues(method, status.Code(err).String()).Inc()
+		}
+		return resp, err
+	}
+}
+
+// grpcMethodName extracts the unqualified method name from a gRPC
+// info.FullMethod such as "/tendermint.rpc.grpc.InspectorService/Block".
+func grpcMethodName(fullMethod string) string {
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[i+1:]
+	}
+	return fullMethod
+}
@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightPtrMapsZeroToNil(t *testing.T) {
+	require.Nil(t, heightPtr(0), "height 0 must mean \"latest\", i.e. a nil *int64, not a literal height of 0")
+}
+
+func TestHeightPtrMapsNonZeroToPointer(t *testing.T) {
+	got := heightPtr(100)
+	require.NotNil(t, got)
+	require.Equal(t, int64(100), *got)
+}
+
+func TestIntPtrMapsZeroToNil(t *testing.T) {
+	require.Nil(t, intPtr(0))
+}
+
+func TestIntPtrMapsNonZeroToPointer(t *testing.T) {
+	got := intPtr(7)
+	require.NotNil(t, got)
+	require.Equal(t, 7, *got)
+}
+
+func TestErrMissingParamNamesTheMissingField(t *testing.T) {
+	err := errMissingParam("hash")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "hash")
+}
@@ -0,0 +1,180 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "tendermint"
+const metricsSubsystem = "inspect_rpc"
+
+// Metrics holds the Prometheus collectors registered for the Inspector rpc
+// handler, labelled by method name so expensive scans like tx_search can be
+// told apart from cheap lookups like block in dashboards and alerts.
+type Metrics struct {
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	responseBytes   *prometheus.HistogramVec
+	inFlight        *prometheus.GaugeVec
+	errorsTotal     *prometheus.CounterVec
+}
+
+// PrometheusMetrics constructs a Metrics instance and registers its
+// collectors with reg.
+func PrometheusMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Number of Inspector RPC requests received, by method.",
+		}, []string{"method"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of Inspector RPC requests, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		responseBytes: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "response_bytes",
+			Help:      "Size of Inspector RPC responses, by method.",
+			Buckets:   prometheus.ExponentialBuckets(64, 4, 8),
+		}, []string{"method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "in_flight_requests",
+			Help:      "Number of Inspector RPC requests currently being served, by method.",
+		}, []string{"method"}),
+		errorsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "errors_total",
+			Help:      "Number of Inspector RPC requests that failed, by method and error class.",
+		}, []string{"method", "class"}),
+	}
+	reg.MustRegister(m.requestsTotal, m.requestDuration, m.responseBytes, m.inFlight, m.errorsTotal)
+	return m
+}
+
+// recordRPCCall starts instrumentation for a single call to method and
+// returns a finish function to call with the resulting error once the
+// handler returns. Routes calls this at the same per-closure call sites it
+// checks limiter.Allow at, so requestsTotal/requestDuration/inFlight/
+// errorsTotal are labelled by the actual RPC method regardless of whether
+// the call arrived over plain JSON-RPC or multiplexed over the websocket -
+// unlike metricsMiddleware, which only sees "/websocket" as the request
+// path for the latter. A nil m is a no-op, returning a no-op finish.
+func (m *Metrics) recordRPCCall(method string) func(error) {
+	if m == nil {
+		return func(error) {}
+	}
+	m.inFlight.WithLabelValues(method).Inc()
+	start := time.Now()
+	return func(err error) {
+		m.inFlight.WithLabelValues(method).Dec()
+		m.requestsTotal.WithLabelValues(method).Inc()
+		m.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		if err != nil {
+			m.errorsTotal.WithLabelValues(method, errorClassFor(err)).Inc()
+		}
+	}
+}
+
+// errorClassFor labels a Routes closure error for errorsTotal. There is no
+// HTTP status code to derive a class from here, so ErrRateLimited is called
+// out explicitly and everything else is grouped as a generic RPC error.
+func errorClassFor(err error) string {
+	if err == ErrRateLimited {
+		return "rate_limited"
+	}
+	return "rpc_error"
+}
+
+// instrumentedResponseWriter captures the response size written by the
+// wrapped handler so it can be recorded as a metric after the request
+// completes.
+type instrumentedResponseWriter struct {
+	http.ResponseWriter
+	bytes int
+}
+
+func (w *instrumentedResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// metricsMiddleware wraps h so that every request records a response size
+// on m. requestsTotal/requestDuration/inFlight/errorsTotal are NOT
+// recorded here: recordRPCCall records those at the same per-closure call
+// sites Routes checks limiter.Allow at, so they are labelled by the actual
+// RPC method whether the call arrives over plain JSON-RPC or multiplexed
+// over the websocket, instead of every websocket-dispatched call being
+// mislabelled "websocket" here. Response size has no such closure-level
+// equivalent (it's the size of the serialized HTTP response), so it stays
+// middleware-level, best-effort-labelled by methodFromRequest.
+func metricsMiddleware(m *Metrics, next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := methodFromRequest(r)
+		iw := &instrumentedResponseWriter{ResponseWriter: w}
+		next.ServeHTTP(iw, r)
+		m.responseBytes.WithLabelValues(method).Observe(float64(iw.bytes))
+	})
+}
+
+// methodFromRequest derives the method name metricsMiddleware labels its
+// collectors with. RegisterRPCFuncs mounts each method at "/"+name for the
+// GET/URL-param calling convention, so a non-root path is just trimmed. The
+// root path "/" is where RegisterRPCFuncs additionally accepts standard
+// JSON-RPC POSTs with the method named in the body rather than the URL, so
+// methodFromRequest peeks that body's "method" field instead of mislabelling
+// every such request "websocket" (the websocket upgrade is handled
+// separately, at "/websocket", and never reaches this path).
+func methodFromRequest(r *http.Request) string {
+	path := methodFromPath(r.URL.Path)
+	if path != "" {
+		return path
+	}
+	if r.Body == nil {
+		return "unknown"
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "unknown"
+	}
+	var req struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil || req.Method == "" {
+		return "unknown"
+	}
+	return req.Method
+}
+
+func methodFromPath(path string) string {
+	for len(path) > 0 && path[0] == '/' {
+		path = path[1:]
+	}
+	return path
+}
+
+// registerMetricsEndpoint mounts the Prometheus /metrics handler on mux so
+// Handler can serve scrape requests alongside the JSON-RPC and websocket
+// endpoints.
+func registerMetricsEndpoint(mux *http.ServeMux, reg prometheus.Gatherer) {
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+}
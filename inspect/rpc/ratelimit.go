@@ -0,0 +1,148 @@
+package rpc
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/config"
+	"golang.org/x/time/rate"
+)
+
+// ipLimiterTTL is how long a per-(method, IP) limiter may sit idle before
+// ipLimiterFor evicts it. Without eviction, ipLimiters would grow by one
+// entry per distinct remote address ever seen and never shrink, a slow
+// memory leak against a public-facing Inspector endpoint.
+const ipLimiterTTL = 10 * time.Minute
+
+// Rate describes a token-bucket limit: up to RequestsPerSecond sustained
+// requests, with bursts up to Burst.
+type Rate struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimitConfig carries the per-method and per-remote-IP rate limits
+// applied to the Inspector's RPC methods. A method with no entry in
+// PerMethod falls back to Default. A zero-value Rate (RequestsPerSecond
+// <= 0) is treated as unlimited.
+type RateLimitConfig struct {
+	Default   Rate
+	PerMethod map[string]Rate
+}
+
+// ErrRateLimited is returned by RateLimiter.Allow's caller when a request
+// is rejected for exceeding its method or per-IP limit.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// ipLimiterEntry pairs a per-(method, IP) bucket with the last time it was
+// consulted, so ipLimiterFor can evict entries that have sat idle past
+// ipLimiterTTL instead of keeping every remote address seen since startup.
+type ipLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// RateLimiter enforces RateLimitConfig, tracking one token bucket per
+// method and one additional token bucket per (method, remote IP) pair so
+// that an expensive scan like tx_search can be throttled independently of
+// a cheap lookup like block, and so that a single abusive client cannot
+// exhaust a method's budget for every other client.
+type RateLimiter struct {
+	cfg RateLimitConfig
+
+	mtx            sync.Mutex
+	methodLimiters map[string]*rate.Limiter
+	ipLimiters     map[string]map[string]*ipLimiterEntry
+}
+
+// NewRateLimiter constructs a RateLimiter from cfg.
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		cfg:            cfg,
+		methodLimiters: make(map[string]*rate.Limiter),
+		ipLimiters:     make(map[string]map[string]*ipLimiterEntry),
+	}
+}
+
+// NewRateLimitConfig builds a RateLimitConfig from the TOML-serializable
+// config.RPCRateLimitConfig.
+func NewRateLimitConfig(cfg config.RPCRateLimitConfig) RateLimitConfig {
+	perMethod := make(map[string]Rate, len(cfg.PerMethod))
+	for method, r := range cfg.PerMethod {
+		perMethod[method] = Rate{RequestsPerSecond: r.RequestsPerSecond, Burst: r.Burst}
+	}
+	return RateLimitConfig{
+		Default:   Rate{RequestsPerSecond: cfg.Default.RequestsPerSecond, Burst: cfg.Default.Burst},
+		PerMethod: perMethod,
+	}
+}
+
+// Allow reports whether a request to method from remoteAddr may proceed,
+// consuming a token from both the method-wide and per-IP buckets if so.
+// A port in remoteAddr ("host:port") is dropped, so every connection from
+// the same IP shares one per-IP bucket.
+func (rl *RateLimiter) Allow(method, remoteAddr string) bool {
+	if rl == nil {
+		return true
+	}
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+	r := rl.rateFor(method)
+	if r.RequestsPerSecond <= 0 {
+		return true
+	}
+	return rl.methodLimiterFor(method, r).Allow() && rl.ipLimiterFor(method, remoteAddr, r).Allow()
+}
+
+func (rl *RateLimiter) rateFor(method string) Rate {
+	if r, ok := rl.cfg.PerMethod[method]; ok {
+		return r
+	}
+	return rl.cfg.Default
+}
+
+func (rl *RateLimiter) methodLimiterFor(method string, r Rate) *rate.Limiter {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+	lim, ok := rl.methodLimiters[method]
+	if !ok {
+		lim = rate.NewLimiter(rate.Limit(r.RequestsPerSecond), r.Burst)
+		rl.methodLimiters[method] = lim
+	}
+	return lim
+}
+
+func (rl *RateLimiter) ipLimiterFor(method, remoteAddr string, r Rate) *rate.Limiter {
+	now := time.Now()
+
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+	byIP, ok := rl.ipLimiters[method]
+	if !ok {
+		byIP = make(map[string]*ipLimiterEntry)
+		rl.ipLimiters[method] = byIP
+	}
+	evictStaleIPLimiters(byIP, now)
+	entry, ok := byIP[remoteAddr]
+	if !ok {
+		entry = &ipLimiterEntry{limiter: rate.NewLimiter(rate.Limit(r.RequestsPerSecond), r.Burst)}
+		byIP[remoteAddr] = entry
+	}
+	entry.lastUsed = now
+	return entry.limiter
+}
+
+// evictStaleIPLimiters removes entries from byIP that have not been used
+// within ipLimiterTTL of now, bounding ipLimiters' memory to the set of
+// remote addresses actively making requests rather than every address ever
+// seen. Called with rl.mtx already held.
+func evictStaleIPLimiters(byIP map[string]*ipLimiterEntry, now time.Time) {
+	for addr, entry := range byIP {
+		if now.Sub(entry.lastUsed) > ipLimiterTTL {
+			delete(byIP, addr)
+		}
+	}
+}
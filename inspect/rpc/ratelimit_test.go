@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterAllowsWithinBurst(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{Default: Rate{RequestsPerSecond: 1, Burst: 2}})
+
+	require.True(t, rl.Allow("block", "1.2.3.4"))
+	require.True(t, rl.Allow("block", "1.2.3.4"))
+	require.False(t, rl.Allow("block", "1.2.3.4"), "a third call within the same instant must exceed the burst of 2")
+}
+
+func TestRateLimiterZeroRateIsUnlimited(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{Default: Rate{RequestsPerSecond: 0}})
+	for i := 0; i < 100; i++ {
+		require.True(t, rl.Allow("block", "1.2.3.4"))
+	}
+}
+
+func TestRateLimiterNilReceiverIsUnlimited(t *testing.T) {
+	var rl *RateLimiter
+	require.True(t, rl.Allow("block", "1.2.3.4"))
+}
+
+func TestRateLimiterPerMethodOverridesDefault(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{
+		Default:   Rate{RequestsPerSecond: 1, Burst: 1},
+		PerMethod: map[string]Rate{"tx_search": {RequestsPerSecond: 1, Burst: 5}},
+	})
+
+	// tx_search gets its own, larger burst than the default.
+	for i := 0; i < 5; i++ {
+		require.True(t, rl.Allow("tx_search", "1.2.3.4"))
+	}
+	require.False(t, rl.Allow("tx_search", "1.2.3.4"))
+
+	// block, with no PerMethod entry, still falls back to the default burst of 1.
+	require.True(t, rl.Allow("block", "1.2.3.4"))
+	require.False(t, rl.Allow("block", "1.2.3.4"))
+}
+
+func TestRateLimiterBudgetIsIndependentPerMethod(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{Default: Rate{RequestsPerSecond: 1, Burst: 1}})
+
+	require.True(t, rl.Allow("block", "1.2.3.4"))
+	require.False(t, rl.Allow("block", "1.2.3.4"), "block's budget is exhausted")
+	require.True(t, rl.Allow("commit", "1.2.3.4"), "commit has its own budget, unaffected by block's")
+}
+
+func TestRateLimiterBudgetIsPerIPInAdditionToPerMethod(t *testing.T) {
+	// The method-wide bucket has enough burst for both IPs; the per-IP
+	// bucket is what actually throttles a single abusive client without
+	// affecting another client calling the same method.
+	rl := NewRateLimiter(RateLimitConfig{Default: Rate{RequestsPerSecond: 1, Burst: 1}})
+
+	require.True(t, rl.Allow("block", "1.1.1.1"))
+	require.False(t, rl.Allow("block", "1.1.1.1"), "1.1.1.1 has exhausted its per-IP budget")
+	require.True(t, rl.Allow("block", "2.2.2.2"), "2.2.2.2 has its own per-IP budget")
+}
+
+func TestEvictStaleIPLimitersRemovesOnlyExpiredEntries(t *testing.T) {
+	now := time.Now()
+	byIP := map[string]*ipLimiterEntry{
+		"stale":  {lastUsed: now.Add(-ipLimiterTTL - time.Second)},
+		"fresh":  {lastUsed: now},
+		"border": {lastUsed: now.Add(-ipLimiterTTL + time.Second)},
+	}
+
+	evictStaleIPLimiters(byIP, now)
+
+	require.NotContains(t, byIP, "stale")
+	require.Contains(t, byIP, "fresh")
+	require.Contains(t, byIP, "border")
+}
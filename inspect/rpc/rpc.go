@@ -2,20 +2,27 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
 	"time"
 
 	"github.com/rs/cors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/pubsub"
 	"github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 	"github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/state/indexer"
 	"github.com/tendermint/tendermint/state/txindex"
-	"github.com/tendermint/tendermint/types"
 )
 
 // Server defines parameters for running an Inspector rpc server.
@@ -24,12 +31,45 @@ type Server struct {
 	Handler http.Handler
 	Logger  log.Logger
 	Config  *config.RPCConfig
+
+	// GRPCAddr is the TCP address the gRPC and gRPC-Web transports listen
+	// on. It is left empty to disable the gRPC transport entirely.
+	GRPCAddr string
+	// GRPCServer is the gRPC server built by NewGRPCServer, served over
+	// GRPCAddr alongside a gRPC-Web shim for browser clients.
+	GRPCServer *grpc.Server
+	// GRPCConfig carries the gRPC-specific server options. It defaults to
+	// DefaultGRPCConfig when nil.
+	GRPCConfig *GRPCConfig
+
+	// Auth carries the bearer-token/JWT, mTLS, and CIDR allowlist options
+	// applied to every endpoint served by Handler. A nil Auth disables all
+	// of the above.
+	Auth *AuthConfig
+
+	// Metrics, if non-nil, records Prometheus metrics for every request
+	// served by Handler and exposes them at "/metrics".
+	Metrics *Metrics
+	// RateLimits, if non-nil, is used to build the RateLimiter passed to
+	// Routes so each method is throttled independently of the others.
+	RateLimits *RateLimitConfig
 }
 
-// Routes returns the set of routes used by the Inspector server.
+// Routes returns the set of routes used by the Inspector server, including
+// the "subscribe"/"unsubscribe"/"unsubscribe_all" historical replay
+// subscriptions backed by the returned EventUnsubscriber. Pass that value
+// to Handler so websocket disconnects clean up the same subscription set
+// the routes registered it against. A non-nil limiter throttles each
+// archival query method independently by both its own budget and the
+// calling remote IP's budget, and a non-nil metrics records requestsTotal/
+// requestDuration/inFlight/errorsTotal for it; both checks happen inside
+// the registered function itself so they are enforced/recorded identically
+// whether the call arrives over JSON-RPC or over the websocket-multiplexed
+// RoutesMap dispatch. The subscribe/unsubscribe/unsubscribe_all routes are
+// registered directly and are neither throttled nor recorded.
 //
-//nolint: lll
-func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, txidx txindex.TxIndexer, blkidx indexer.BlockIndexer, logger log.Logger) core.RoutesMap {
+// nolint: lll
+func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, txidx txindex.TxIndexer, blkidx indexer.BlockIndexer, limiter *RateLimiter, metrics *Metrics, logger log.Logger) (core.RoutesMap, EventUnsubscriber) {
 	env := &core.Environment{
 		Config:           cfg,
 		BlockIndexer:     blkidx,
@@ -39,29 +79,128 @@ func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, txidx txin
 		ConsensusReactor: waitSyncCheckerImpl{},
 		Logger:           logger,
 	}
-	return core.RoutesMap{
-		"blockchain":       server.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight"),
-		"consensus_params": server.NewRPCFunc(env.ConsensusParams, "height"),
-		"block":            server.NewRPCFunc(env.Block, "height"),
-		"block_by_hash":    server.NewRPCFunc(env.BlockByHash, "hash"),
-		"block_results":    server.NewRPCFunc(env.BlockResults, "height"),
-		"commit":           server.NewRPCFunc(env.Commit, "height"),
-		"validators":       server.NewRPCFunc(env.Validators, "height,page,per_page"),
-		"tx":               server.NewRPCFunc(env.Tx, "hash,prove"),
-		"tx_search":        server.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
-		"block_search":     server.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
+	eventBus := newReplayEventBus(bs, txidx, blkidx, logger)
+	routes := core.RoutesMap{
+		"blockchain": server.NewRPCFunc(func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
+			finish := metrics.recordRPCCall("blockchain")
+			if !limiter.Allow("blockchain", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.BlockchainInfo(ctx, minHeight, maxHeight)
+			finish(err)
+			return result, err
+		}, "minHeight,maxHeight"),
+		"consensus_params": server.NewRPCFunc(func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
+			finish := metrics.recordRPCCall("consensus_params")
+			if !limiter.Allow("consensus_params", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.ConsensusParams(ctx, height)
+			finish(err)
+			return result, err
+		}, "height"),
+		"block": server.NewRPCFunc(func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlock, error) {
+			finish := metrics.recordRPCCall("block")
+			if !limiter.Allow("block", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.Block(ctx, height)
+			finish(err)
+			return result, err
+		}, "height"),
+		"block_by_hash": server.NewRPCFunc(func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultBlock, error) {
+			finish := metrics.recordRPCCall("block_by_hash")
+			if !limiter.Allow("block_by_hash", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.BlockByHash(ctx, hash)
+			finish(err)
+			return result, err
+		}, "hash"),
+		"block_results": server.NewRPCFunc(func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+			finish := metrics.recordRPCCall("block_results")
+			if !limiter.Allow("block_results", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.BlockResults(ctx, height)
+			finish(err)
+			return result, err
+		}, "height"),
+		"commit": server.NewRPCFunc(func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommit, error) {
+			finish := metrics.recordRPCCall("commit")
+			if !limiter.Allow("commit", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.Commit(ctx, height)
+			finish(err)
+			return result, err
+		}, "height"),
+		"validators": server.NewRPCFunc(func(ctx *rpctypes.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+			finish := metrics.recordRPCCall("validators")
+			if !limiter.Allow("validators", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.Validators(ctx, height, page, perPage)
+			finish(err)
+			return result, err
+		}, "height,page,per_page"),
+		"tx": server.NewRPCFunc(func(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+			finish := metrics.recordRPCCall("tx")
+			if !limiter.Allow("tx", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.Tx(ctx, hash, prove)
+			finish(err)
+			return result, err
+		}, "hash,prove"),
+		"tx_search": server.NewRPCFunc(func(ctx *rpctypes.Context, query string, prove bool, page, perPage *int, orderBy string) (*ctypes.ResultTxSearch, error) {
+			finish := metrics.recordRPCCall("tx_search")
+			if !limiter.Allow("tx_search", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.TxSearch(ctx, query, prove, page, perPage, orderBy)
+			finish(err)
+			return result, err
+		}, "query,prove,page,per_page,order_by"),
+		"block_search": server.NewRPCFunc(func(ctx *rpctypes.Context, query string, page, perPage *int, orderBy string) (*ctypes.ResultBlockSearch, error) {
+			finish := metrics.recordRPCCall("block_search")
+			if !limiter.Allow("block_search", ctx.RemoteAddr()) {
+				finish(ErrRateLimited)
+				return nil, ErrRateLimited
+			}
+			result, err := env.BlockSearch(ctx, query, page, perPage, orderBy)
+			finish(err)
+			return result, err
+		}, "query,page,per_page,order_by"),
+		"subscribe":       server.NewRPCFunc(eventBus.Subscribe, "query,from_height"),
+		"unsubscribe":     server.NewRPCFunc(eventBus.Unsubscribe, "query"),
+		"unsubscribe_all": server.NewRPCFunc(eventBus.UnsubscribeAllRPC, ""),
 	}
+	return routes, eventBus
 }
 
 // Handler returns the http.Handler configured for use with an Inspector server. Handler
 // registers the routes on the http.Handler and also registers the websocket handler
-// and the CORS handler if specified by the configuration options.
-func Handler(rpcConfig *config.RPCConfig, routes core.RoutesMap, logger log.Logger) http.Handler {
+// and the CORS handler if specified by the configuration options. eventBus is notified
+// of disconnects so it can forget subscriptions for the departed connection; it is
+// typically the same replayEventBus instance backing the routes built by Routes. auth,
+// if non-nil, applies the bearer-token/JWT and CIDR allowlist middleware uniformly in
+// front of both the JSON-RPC and websocket endpoints. metrics, if non-nil, records
+// request metrics for every route, including the websocket handler, and is also
+// exposed for scraping at "/metrics".
+func Handler(rpcConfig *config.RPCConfig, routes core.RoutesMap, eventBus EventUnsubscriber, auth *AuthConfig, metrics *Metrics, logger log.Logger) http.Handler {
 	mux := http.NewServeMux()
 	wmLogger := logger.With("protocol", "websocket")
 
-	var eventBus types.EventBusSubscriber
-
 	websocketDisconnectFn := func(remoteAddr string) {
 		err := eventBus.UnsubscribeAll(context.Background(), remoteAddr)
 		if err != nil && err != pubsub.ErrSubscriptionNotFound {
@@ -75,10 +214,17 @@ func Handler(rpcConfig *config.RPCConfig, routes core.RoutesMap, logger log.Logg
 	mux.HandleFunc("/websocket", wm.WebsocketHandler)
 
 	server.RegisterRPCFuncs(mux, routes, logger)
+	if metrics != nil {
+		registerMetricsEndpoint(mux, prometheus.DefaultGatherer)
+	}
 	var rootHandler http.Handler = mux
+	if metrics != nil {
+		rootHandler = metricsMiddleware(metrics, rootHandler)
+	}
 	if rpcConfig.IsCorsEnabled() {
-		rootHandler = addCORSHandler(rpcConfig, mux)
+		rootHandler = addCORSHandler(rpcConfig, rootHandler)
 	}
+	rootHandler = authMiddleware(auth, logger, rootHandler)
 	return rootHandler
 }
 
@@ -113,7 +259,9 @@ func (srv *Server) ListenAndServe(ctx context.Context) error {
 }
 
 // ListenAndServeTLS listens on the address specified in srv.Addr. ListenAndServeTLS handles
-// incoming requests over HTTPS using the Inspector rpc handler specified on the server.
+// incoming requests over HTTPS using the Inspector rpc handler specified on the server. If
+// srv.Auth.ClientCAs is set, it additionally requires and verifies a client certificate
+// signed by one of those CAs before any request reaches the handler (mTLS).
 func (srv *Server) ListenAndServeTLS(ctx context.Context, certFile, keyFile string) error {
 	listener, err := server.Listen(srv.Addr, srv.Config.MaxOpenConnections)
 	if err != nil {
@@ -123,9 +271,50 @@ func (srv *Server) ListenAndServeTLS(ctx context.Context, certFile, keyFile stri
 		<-ctx.Done()
 		listener.Close()
 	}()
+	if tlsCfg := tlsConfig(srv.Auth); tlsCfg != nil {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+		listener = tls.NewListener(listener, tlsCfg)
+		cfg := serverRPCConfig(srv.Config)
+		httpServer := &http.Server{
+			Handler:        srv.Handler,
+			ReadTimeout:    cfg.ReadTimeout,
+			WriteTimeout:   cfg.WriteTimeout,
+			MaxHeaderBytes: cfg.MaxHeaderBytes,
+		}
+		return httpServer.Serve(listener)
+	}
 	return server.ServeTLS(listener, srv.Handler, certFile, keyFile, srv.Logger, serverRPCConfig(srv.Config))
 }
 
+// ListenAndServeGRPC listens on the address specified in srv.GRPCAddr and serves
+// the InspectorService over gRPC, with gRPC-Web requests (as issued by browser
+// clients) multiplexed onto the same listener alongside native gRPC requests.
+// It is a no-op if srv.GRPCAddr is empty.
+func (srv *Server) ListenAndServeGRPC(ctx context.Context) error {
+	if srv.GRPCAddr == "" {
+		return nil
+	}
+	listener, err := server.Listen(srv.GRPCAddr, srv.Config.MaxOpenConnections)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	// h2c lets a native gRPC client speak cleartext HTTP/2 to this
+	// listener; without it, http.Server only negotiates HTTP/2 via TLS
+	// ALPN and a non-TLS listener would serve gRPC-Web (HTTP/1.1) only.
+	httpServer := &http.Server{
+		Handler: h2c.NewHandler(srv.grpcWebHandler(srv.GRPCServer), &http2.Server{}),
+	}
+	return httpServer.Serve(listener)
+}
+
 func serverRPCConfig(r *config.RPCConfig) *server.Config {
 	cfg := server.DefaultConfig()
 	cfg.MaxBodyBytes = r.MaxBodyBytes
@@ -137,4 +326,4 @@ func serverRPCConfig(r *config.RPCConfig) *server.Config {
 		cfg.WriteTimeout = r.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 	return cfg
-}
\ No newline at end of file
+}
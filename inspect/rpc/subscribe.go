@@ -0,0 +1,180 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/indexer"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+// EventUnsubscriber is satisfied by anything that can forget every
+// subscription registered for a websocket connection, so the websocket
+// manager's disconnect callback can clean up without depending on a live
+// types.EventBus.
+type EventUnsubscriber interface {
+	UnsubscribeAll(ctx context.Context, remoteAddr string) error
+}
+
+// replayEventBus backs the Inspector's "subscribe"/"unsubscribe"/
+// "unsubscribe_all" RPC methods with historical event replay instead of a
+// live EventBus, since the Inspector runs against a stopped node's indexed
+// storage rather than a running consensus engine. A client subscribes with
+// a query and an optional from_height; the server replays every indexed
+// NewBlock/Tx event matching the query from that height onward (all
+// NewBlock events in height order, then all Tx events in height order; the
+// two are not interleaved by height), and then keeps the subscription
+// registered (as a no-op) until the
+// client unsubscribes or disconnects.
+type replayEventBus struct {
+	blockStore   state.BlockStore
+	txIndexer    txindex.TxIndexer
+	blockIndexer indexer.BlockIndexer
+	logger       log.Logger
+
+	mtx  sync.Mutex
+	subs map[string]map[string]struct{} // remoteAddr -> query string -> struct{}
+}
+
+// newReplayEventBus constructs a replayEventBus over the given block store
+// and indexers. bs is used to load the actual block for each replayed
+// NewBlock event, the same way a live EventBus's NewBlock event carries
+// the block consensus just finalized.
+func newReplayEventBus(bs state.BlockStore, txIdx txindex.TxIndexer, blkIdx indexer.BlockIndexer, logger log.Logger) *replayEventBus {
+	return &replayEventBus{
+		blockStore:   bs,
+		txIndexer:    txIdx,
+		blockIndexer: blkIdx,
+		logger:       logger,
+		subs:         make(map[string]map[string]struct{}),
+	}
+}
+
+// Subscribe parses query, replays every indexed event matching it starting
+// at fromHeight (or height 1 if fromHeight is zero) over the websocket
+// connection in ctx, and registers the subscription so that a later call
+// to Unsubscribe/UnsubscribeAll can find it.
+func (b *replayEventBus) Subscribe(ctx *rpctypes.Context, query string, fromHeight int64) (*ctypes.ResultSubscribe, error) {
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+	if fromHeight <= 0 {
+		fromHeight = 1
+	}
+
+	addr := ctx.RemoteAddr()
+	b.logger.Info("replaying historical events for subscription", "remote", addr, "query", query, "from_height", fromHeight)
+
+	b.mtx.Lock()
+	if b.subs[addr] == nil {
+		b.subs[addr] = make(map[string]struct{})
+	}
+	b.subs[addr][query] = struct{}{}
+	b.mtx.Unlock()
+
+	go b.replay(ctx, addr, query, q, fromHeight)
+
+	return &ctypes.ResultSubscribe{}, nil
+}
+
+// Unsubscribe removes query from the set of subscriptions registered for
+// the remote connection in ctx.
+func (b *replayEventBus) Unsubscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultUnsubscribe, error) {
+	addr := ctx.RemoteAddr()
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if queries, ok := b.subs[addr]; ok {
+		delete(queries, query)
+		if len(queries) == 0 {
+			delete(b.subs, addr)
+		}
+	}
+	return &ctypes.ResultUnsubscribe{}, nil
+}
+
+// UnsubscribeAllRPC removes every subscription registered for the remote
+// connection in ctx. It is registered under the "unsubscribe_all" route.
+func (b *replayEventBus) UnsubscribeAllRPC(ctx *rpctypes.Context) (*ctypes.ResultUnsubscribe, error) {
+	return &ctypes.ResultUnsubscribe{}, b.UnsubscribeAll(ctx.Context(), ctx.RemoteAddr())
+}
+
+// UnsubscribeAll removes every subscription registered for remoteAddr. It
+// satisfies EventUnsubscriber so it can be passed directly to Handler as
+// the websocket manager's disconnect callback.
+func (b *replayEventBus) UnsubscribeAll(_ context.Context, remoteAddr string) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	delete(b.subs, remoteAddr)
+	return nil
+}
+
+// isSubscribed reports whether remoteAddr still has query registered. The
+// in-flight replay goroutine consults this so a mid-replay Unsubscribe or
+// UnsubscribeAll call stops further delivery.
+func (b *replayEventBus) isSubscribed(remoteAddr, query string) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	_, ok := b.subs[remoteAddr][query]
+	return ok
+}
+
+// replay walks the indexed history matching q from fromHeight and writes
+// one ResultEvent per matching block/tx to the subscriber's websocket
+// connection: every matching block in height order first, then every
+// matching tx in height order.
+func (b *replayEventBus) replay(wsCtx *rpctypes.Context, remoteAddr, rawQuery string, q *tmquery.Query, fromHeight int64) {
+	ctx := wsCtx.Context()
+
+	// The block and tx indexers are searched and published independently
+	// (log-and-continue, not log-and-return) so that one indexer's failure
+	// cannot suppress results the other already fetched successfully.
+	if heights, err := b.blockIndexer.Search(ctx, q); err != nil {
+		b.logger.Error("failed to search block indexer for subscription replay", "query", rawQuery, "err", err)
+	} else {
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+		for _, height := range heights {
+			if height < fromHeight || !b.isSubscribed(remoteAddr, rawQuery) {
+				continue
+			}
+			block := b.blockStore.LoadBlock(height)
+			if block == nil {
+				b.logger.Error("no block found for indexed height during subscription replay", "query", rawQuery, "height", height)
+				continue
+			}
+			b.publish(wsCtx, remoteAddr, rawQuery, types.EventDataNewBlock{Block: block}, height)
+		}
+	}
+
+	if txResults, err := b.txIndexer.Search(ctx, q); err != nil {
+		b.logger.Error("failed to search tx indexer for subscription replay", "query", rawQuery, "err", err)
+	} else {
+		sort.Slice(txResults, func(i, j int) bool { return txResults[i].Height < txResults[j].Height })
+		for _, txResult := range txResults {
+			if txResult.Height < fromHeight || !b.isSubscribed(remoteAddr, rawQuery) {
+				continue
+			}
+			b.publish(wsCtx, remoteAddr, rawQuery, types.EventDataTx{TxResult: *txResult}, txResult.Height)
+		}
+	}
+}
+
+// publish writes a single replayed event to the subscriber's websocket
+// connection. Failures are logged rather than returned, mirroring how a
+// live EventBus subscription delivery loop cannot propagate errors back
+// to the originating RPC call either.
+func (b *replayEventBus) publish(wsCtx *rpctypes.Context, remoteAddr, rawQuery string, data types.TMEventData, height int64) {
+	resultEvent := &ctypes.ResultEvent{Query: rawQuery, Data: data}
+	resp := rpctypes.NewRPCSuccessResponse(wsCtx.JSONReq.ID, resultEvent)
+	if err := wsCtx.WSConn.WriteRPCResponse(wsCtx.Context(), resp); err != nil {
+		b.logger.Error("failed to deliver replayed event", "remote", remoteAddr, "height", height, "query", rawQuery, "err", err)
+	}
+}
@@ -0,0 +1,72 @@
+package canonical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestAcceptSignBytesHeightWindow(t *testing.T) {
+	const upgradeHeight = int64(100)
+	const upgradeWindow = int64(10)
+	legacy := []byte("legacy-preimage")
+	domainSeparated := []byte("domain-separated-preimage")
+
+	tests := []struct {
+		name    string
+		height  int64
+		got     []byte
+		wantErr bool
+	}{
+		{"below upgrade height accepts legacy", upgradeHeight - 1, legacy, false},
+		{"below upgrade height rejects domain-separated", upgradeHeight - 1, domainSeparated, true},
+		{"at upgrade height accepts legacy", upgradeHeight, legacy, false},
+		{"at upgrade height accepts domain-separated", upgradeHeight, domainSeparated, false},
+		{"last height of window accepts legacy", upgradeHeight + upgradeWindow - 1, legacy, false},
+		{"last height of window accepts domain-separated", upgradeHeight + upgradeWindow - 1, domainSeparated, false},
+		{"first height past window rejects legacy", upgradeHeight + upgradeWindow, legacy, true},
+		{"first height past window accepts domain-separated", upgradeHeight + upgradeWindow, domainSeparated, false},
+		{"well past window rejects legacy", upgradeHeight + upgradeWindow + 1000, legacy, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := AcceptSignBytes(tc.height, upgradeHeight, upgradeWindow, tc.got, legacy, domainSeparated)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAcceptSignBytesRejectsUnrelatedBytes(t *testing.T) {
+	err := AcceptSignBytes(1, 0, 0, []byte("garbage"), []byte("legacy"), []byte("domain-separated"))
+	require.Error(t, err)
+}
+
+func TestSignBytesWithDomainDiffersByVoteType(t *testing.T) {
+	fd := ComputeForkDigest([]byte("genesis"), []byte("v1"))
+	blockID := &types.CanonicalBlockID{}
+
+	prevote := &types.CanonicalVote{Type: types.PrevoteType, Height: 1, BlockID: blockID, ChainID: "c"}
+	precommit := &types.CanonicalVote{Type: types.PrecommitType, Height: 1, BlockID: blockID, ChainID: "c"}
+
+	prevoteBytes, err := SignBytesWithDomain("c", fd, prevote)
+	require.NoError(t, err)
+	precommitBytes, err := SignBytesWithDomain("c", fd, precommit)
+	require.NoError(t, err)
+
+	require.NotEqual(t, prevoteBytes, precommitBytes, "a prevote and precommit over otherwise identical fields must sign different bytes")
+	require.Equal(t, SigningDomainPrevote[:], prevoteBytes[:4])
+	require.Equal(t, SigningDomainPrecommit[:], precommitBytes[:4])
+}
+
+func TestSignBytesWithDomainRejectsUnknownVoteType(t *testing.T) {
+	fd := ComputeForkDigest([]byte("genesis"), []byte("v1"))
+	vote := &types.CanonicalVote{Type: types.SignedMsgType(99), Height: 1, BlockID: &types.CanonicalBlockID{}, ChainID: "c"}
+	_, err := SignBytesWithDomain("c", fd, vote)
+	require.Error(t, err)
+}
@@ -0,0 +1,283 @@
+// Package canonical provides an SSZ-style deterministic encoding for the
+// canonical vote/proposal types, as an alternative to the proto wire format
+// those types normally sign. Validators keep signing proto-encoded bytes by
+// default; this package exists for signature aggregation schemes (e.g. a
+// BLS12-381 sidecar) where signers need a fixed-layout encoding and a cheap,
+// incrementally-hashable 32-byte root instead of parsing proto to verify a
+// batch of votes.
+package canonical
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const chunkSize = 32
+
+// SSZMarshal encodes m using a fixed-layout, length-prefix-free scheme:
+// fixed-size fields are written in declaration order, and each variable-size
+// field (ChainID, and for CanonicalVoteExtension also Extension) is replaced
+// in the fixed part by a uint32 offset into the heap region appended after
+// it, where the variable fields' bytes are written in declaration order. The
+// offset region is omitted entirely when m has no variable-size fields,
+// which is the case for CanonicalBlockID and CanonicalPartSetHeader.
+func SSZMarshal(m interface{}) ([]byte, error) {
+	switch v := m.(type) {
+	case *types.CanonicalPartSetHeader:
+		return sszPartSetHeader(v), nil
+	case *types.CanonicalBlockID:
+		return sszBlockID(v), nil
+	case *types.CanonicalProposal:
+		return sszProposal(v), nil
+	case *types.CanonicalVote:
+		return sszVote(v), nil
+	case *types.CanonicalVoteExtension:
+		return sszVoteExtension(v), nil
+	default:
+		return nil, fmt.Errorf("canonical: SSZMarshal: unsupported type %T", m)
+	}
+}
+
+// HashTreeRoot computes the SSZ merkle root of m: every field is packed
+// into a 32-byte chunk (container and variable-length fields contribute the
+// root of their own sub-tree instead), the resulting chunk list is
+// zero-padded to a power of two and merkleized bottom-up with SHA-256, and
+// finally the type tag for m is mixed into the root so that two messages
+// with identical field values but different concrete types never collide.
+func HashTreeRoot(m interface{}) ([32]byte, error) {
+	switch v := m.(type) {
+	case *types.CanonicalPartSetHeader:
+		return mixInType("CanonicalPartSetHeader", partSetHeaderChunks(v)), nil
+	case *types.CanonicalBlockID:
+		return mixInType("CanonicalBlockID", blockIDChunks(v)), nil
+	case *types.CanonicalProposal:
+		return mixInType("CanonicalProposal", proposalChunks(v)), nil
+	case *types.CanonicalVote:
+		return mixInType("CanonicalVote", voteChunks(v)), nil
+	case *types.CanonicalVoteExtension:
+		return mixInType("CanonicalVoteExtension", voteExtensionChunks(v)), nil
+	default:
+		return [32]byte{}, fmt.Errorf("canonical: HashTreeRoot: unsupported type %T", m)
+	}
+}
+
+// --- SSZMarshal ---
+
+func sszPartSetHeader(v *types.CanonicalPartSetHeader) []byte {
+	if v == nil {
+		v = &types.CanonicalPartSetHeader{}
+	}
+	buf := make([]byte, 0, 4+chunkSize)
+	buf = append(buf, encodeUint32(v.Total)...)
+	buf = append(buf, fixedHash32(v.Hash)...)
+	return buf
+}
+
+func sszBlockID(v *types.CanonicalBlockID) []byte {
+	if v == nil {
+		v = &types.CanonicalBlockID{}
+	}
+	buf := make([]byte, 0, chunkSize+4+chunkSize)
+	buf = append(buf, fixedHash32(v.Hash)...)
+	buf = append(buf, sszPartSetHeader(&v.PartSetHeader)...)
+	return buf
+}
+
+// blockIDSize is the fixed size of an SSZMarshal-ed CanonicalBlockID: 32
+// bytes for Hash plus the 36-byte fixed PartSetHeader.
+const blockIDSize = chunkSize + 4 + chunkSize
+
+func sszProposal(v *types.CanonicalProposal) []byte {
+	if v == nil {
+		v = &types.CanonicalProposal{}
+	}
+	const fixedLen = 4 + 8 + 8 + 8 + blockIDSize + 8 + 4 // Type,Height,Round,POLRound,BlockID,Timestamp,chainIDOffset
+	fixed := make([]byte, 0, fixedLen)
+	fixed = append(fixed, encodeUint32(uint32(v.Type))...)
+	fixed = append(fixed, encodeInt64(v.Height)...)
+	fixed = append(fixed, encodeInt64(v.Round)...)
+	fixed = append(fixed, encodeInt64(v.POLRound)...)
+	fixed = append(fixed, sszBlockID(v.BlockID)...)
+	fixed = append(fixed, encodeUint64(uint64(v.Timestamp.UnixNano()))...)
+	fixed = append(fixed, encodeUint32(uint32(fixedLen))...)
+	return append(fixed, []byte(v.ChainID)...)
+}
+
+func sszVote(v *types.CanonicalVote) []byte {
+	if v == nil {
+		v = &types.CanonicalVote{}
+	}
+	const fixedLen = 4 + 8 + 8 + blockIDSize + 8 + 4 // Type,Height,Round,BlockID,Timestamp,chainIDOffset
+	fixed := make([]byte, 0, fixedLen)
+	fixed = append(fixed, encodeUint32(uint32(v.Type))...)
+	fixed = append(fixed, encodeInt64(v.Height)...)
+	fixed = append(fixed, encodeInt64(v.Round)...)
+	fixed = append(fixed, sszBlockID(v.BlockID)...)
+	fixed = append(fixed, encodeUint64(uint64(v.Timestamp.UnixNano()))...)
+	fixed = append(fixed, encodeUint32(uint32(fixedLen))...)
+	return append(fixed, []byte(v.ChainID)...)
+}
+
+// sszVoteExtension has two variable-size fields, Extension and ChainID, so
+// unlike sszProposal/sszVote its fixed part carries two heap offsets, one
+// per variable field in declaration order; the heap itself holds Extension's
+// bytes followed by ChainID's.
+func sszVoteExtension(v *types.CanonicalVoteExtension) []byte {
+	if v == nil {
+		v = &types.CanonicalVoteExtension{}
+	}
+	const fixedLen = 4 + 8 + 8 + 4 // extensionOffset,Height,Round,chainIDOffset
+	fixed := make([]byte, 0, fixedLen)
+	fixed = append(fixed, encodeUint32(uint32(fixedLen))...)
+	fixed = append(fixed, encodeInt64(v.Height)...)
+	fixed = append(fixed, encodeInt64(v.Round)...)
+	fixed = append(fixed, encodeUint32(uint32(fixedLen+len(v.Extension)))...)
+	heap := append(append([]byte{}, v.Extension...), []byte(v.ChainID)...)
+	return append(fixed, heap...)
+}
+
+func encodeUint32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+func encodeUint64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, v)
+	return b
+}
+
+// encodeInt64 writes v as 8 little-endian bytes of its two's-complement
+// representation, so that e.g. POLRound == -1 round-trips exactly.
+func encodeInt64(v int64) []byte {
+	return encodeUint64(uint64(v))
+}
+
+// fixedHash32 returns hash as a 32-byte value: zero-padded on the right if
+// shorter (an empty Hash therefore merkleizes as 32 zero bytes), truncated
+// if longer than 32 bytes.
+func fixedHash32(hash []byte) []byte {
+	out := make([]byte, chunkSize)
+	copy(out, hash)
+	return out
+}
+
+// --- HashTreeRoot ---
+
+func chunk(b []byte) [32]byte {
+	var c [32]byte
+	copy(c[:], b)
+	return c
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
+
+// merkleize zero-pads chunks to the next power of two (at least one chunk)
+// and combines them pairwise bottom-up with SHA-256 until a single root
+// remains.
+func merkleize(chunks [][32]byte) [32]byte {
+	n := 1
+	for n < len(chunks) {
+		n *= 2
+	}
+	padded := make([][32]byte, n)
+	copy(padded, chunks)
+
+	for n > 1 {
+		n /= 2
+		for i := 0; i < n; i++ {
+			padded[i] = hashPair(padded[2*i], padded[2*i+1])
+		}
+	}
+	return padded[0]
+}
+
+// bytesRoot merkleizes b's 32-byte chunks (the last one zero-padded) and
+// mixes in b's length, mirroring how SSZ roots a variable-length byte list.
+func bytesRoot(b []byte) [32]byte {
+	var chunks [][32]byte
+	for i := 0; i < len(b); i += chunkSize {
+		end := i + chunkSize
+		if end > len(b) {
+			end = len(b)
+		}
+		chunks = append(chunks, chunk(b[i:end]))
+	}
+	root := merkleize(chunks)
+	return hashPair(root, chunk(encodeUint64(uint64(len(b)))))
+}
+
+func partSetHeaderChunks(v *types.CanonicalPartSetHeader) [][32]byte {
+	if v == nil {
+		v = &types.CanonicalPartSetHeader{}
+	}
+	return [][32]byte{
+		chunk(encodeUint32(v.Total)),
+		chunk(fixedHash32(v.Hash)),
+	}
+}
+
+func blockIDChunks(v *types.CanonicalBlockID) [][32]byte {
+	if v == nil {
+		v = &types.CanonicalBlockID{}
+	}
+	return [][32]byte{
+		chunk(fixedHash32(v.Hash)),
+		merkleize(partSetHeaderChunks(&v.PartSetHeader)),
+	}
+}
+
+func proposalChunks(v *types.CanonicalProposal) [][32]byte {
+	if v == nil {
+		v = &types.CanonicalProposal{}
+	}
+	return [][32]byte{
+		chunk(encodeUint32(uint32(v.Type))),
+		chunk(encodeInt64(v.Height)),
+		chunk(encodeInt64(v.Round)),
+		chunk(encodeInt64(v.POLRound)),
+		merkleize(blockIDChunks(v.BlockID)),
+		chunk(encodeUint64(uint64(v.Timestamp.UnixNano()))),
+		bytesRoot([]byte(v.ChainID)),
+	}
+}
+
+func voteChunks(v *types.CanonicalVote) [][32]byte {
+	if v == nil {
+		v = &types.CanonicalVote{}
+	}
+	return [][32]byte{
+		chunk(encodeUint32(uint32(v.Type))),
+		chunk(encodeInt64(v.Height)),
+		chunk(encodeInt64(v.Round)),
+		merkleize(blockIDChunks(v.BlockID)),
+		chunk(encodeUint64(uint64(v.Timestamp.UnixNano()))),
+		bytesRoot([]byte(v.ChainID)),
+	}
+}
+
+func voteExtensionChunks(v *types.CanonicalVoteExtension) [][32]byte {
+	if v == nil {
+		v = &types.CanonicalVoteExtension{}
+	}
+	return [][32]byte{
+		bytesRoot(v.Extension),
+		chunk(encodeInt64(v.Height)),
+		chunk(encodeInt64(v.Round)),
+		bytesRoot([]byte(v.ChainID)),
+	}
+}
+
+func mixInType(tag string, chunks [][32]byte) [32]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	return hashPair(tagHash, merkleize(chunks))
+}
@@ -0,0 +1,112 @@
+package canonical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestSSZMarshalPartSetHeaderHasNoOffsetTable(t *testing.T) {
+	// CanonicalPartSetHeader has no variable-size fields, so SSZMarshal must
+	// emit exactly the fixed part with no heap region appended.
+	v := &types.CanonicalPartSetHeader{Total: 3, Hash: []byte{0x01, 0x02}}
+	out, err := SSZMarshal(v)
+	require.NoError(t, err)
+	require.Len(t, out, 4+chunkSize, "PartSetHeader encoding must be exactly Total(4)+Hash(32) bytes, with no offset table")
+}
+
+func TestSSZMarshalBlockIDHasNoOffsetTable(t *testing.T) {
+	// CanonicalBlockID is also entirely fixed-size (Hash plus a nested
+	// fixed-size PartSetHeader).
+	v := &types.CanonicalBlockID{Hash: []byte{0xaa}}
+	out, err := SSZMarshal(v)
+	require.NoError(t, err)
+	require.Equal(t, blockIDSize, len(out))
+}
+
+func TestSSZMarshalEmptyHashZeroPads(t *testing.T) {
+	// An empty/nil Hash must merkleize and marshal as 32 zero bytes, not be
+	// dropped or left short.
+	v := &types.CanonicalPartSetHeader{}
+	out, err := SSZMarshal(v)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, chunkSize), out[4:])
+
+	root, err := HashTreeRoot(v)
+	require.NoError(t, err)
+	require.NotEqual(t, [32]byte{}, root, "HashTreeRoot must still mix in the type tag even when every field is zero")
+}
+
+func TestSSZMarshalProposalNegativePOLRoundRoundTrips(t *testing.T) {
+	// POLRound == -1 is the CanonicalProposal sentinel for "no polka";
+	// encodeInt64 must preserve its two's-complement bit pattern
+	// rather than, say, clamping it to zero.
+	v := &types.CanonicalProposal{
+		Type:      1,
+		Height:    10,
+		Round:     2,
+		POLRound:  -1,
+		BlockID:   &types.CanonicalBlockID{},
+		Timestamp: time.Unix(0, 0).UTC(),
+		ChainID:   "test-chain",
+	}
+	out, err := SSZMarshal(v)
+	require.NoError(t, err)
+
+	const polRoundOffset = 4 + 8 + 8 // after Type, Height, Round
+	got := int64(uint64(out[polRoundOffset]) |
+		uint64(out[polRoundOffset+1])<<8 |
+		uint64(out[polRoundOffset+2])<<16 |
+		uint64(out[polRoundOffset+3])<<24 |
+		uint64(out[polRoundOffset+4])<<32 |
+		uint64(out[polRoundOffset+5])<<40 |
+		uint64(out[polRoundOffset+6])<<48 |
+		uint64(out[polRoundOffset+7])<<56)
+	require.Equal(t, int64(-1), got)
+}
+
+func TestSSZMarshalVoteExtensionHeapOrdering(t *testing.T) {
+	// CanonicalVoteExtension has two variable-size fields (Extension,
+	// ChainID); the heap region must hold their bytes in declaration order
+	// regardless of which is longer.
+	v := &types.CanonicalVoteExtension{
+		Extension: []byte("ext-bytes"),
+		Height:    5,
+		Round:     1,
+		ChainID:   "test-chain",
+	}
+	out, err := SSZMarshal(v)
+	require.NoError(t, err)
+
+	const fixedLen = 4 + 8 + 8 + 4
+	heap := out[fixedLen:]
+	require.Equal(t, append([]byte("ext-bytes"), []byte("test-chain")...), heap)
+}
+
+func TestHashTreeRootDiffersByType(t *testing.T) {
+	// mixInType must keep two otherwise-identical field layouts from
+	// colliding across concrete types (e.g. a CanonicalVote and a
+	// CanonicalProposal sharing the same Height/Round/BlockID).
+	blockID := &types.CanonicalBlockID{}
+	ts := time.Unix(0, 0).UTC()
+
+	vote := &types.CanonicalVote{Type: 1, Height: 1, Round: 0, BlockID: blockID, Timestamp: ts, ChainID: "c"}
+	proposal := &types.CanonicalProposal{Type: 1, Height: 1, Round: 0, POLRound: 0, BlockID: blockID, Timestamp: ts, ChainID: "c"}
+
+	voteRoot, err := HashTreeRoot(vote)
+	require.NoError(t, err)
+	proposalRoot, err := HashTreeRoot(proposal)
+	require.NoError(t, err)
+	require.NotEqual(t, voteRoot, proposalRoot)
+}
+
+func TestSSZMarshalUnsupportedType(t *testing.T) {
+	_, err := SSZMarshal("not a canonical message")
+	require.Error(t, err)
+
+	_, err = HashTreeRoot("not a canonical message")
+	require.Error(t, err)
+}